@@ -0,0 +1,86 @@
+package future
+
+import "context"
+
+// Map, FlatMap, Recover, and Zip let callers compose async pipelines out of
+// existing Futures without spawning goroutines by hand. They are
+// package-level functions, not methods on Future, because Go does not allow
+// a method to introduce type parameters beyond its receiver's. Each returns
+// a new Future backed by its own goroutine that awaits its input(s) and
+// applies the transformation, so they share StartFuture's panic-to-error
+// and ctx-cancellation guarantees: a panic inside fn becomes an error result
+// instead of crashing the goroutine, and Await(ctx) on the result returns
+// ctx.Err() as soon as ctx is done even if the upstream Future has not
+// resolved yet.
+
+// Map returns a Future that resolves to fn applied to f's value, or f's
+// error unchanged if f fails.
+func Map[T, U any](f *Future[T], fn func(T) (U, error)) *Future[U] {
+	return StartFuture(context.Background(), func(ctx context.Context) (U, error) {
+		v, err := f.Await(ctx)
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return fn(v)
+	})
+}
+
+// FlatMap returns a Future that resolves to the Future fn produces from f's
+// value, or f's error unchanged if f fails.
+func FlatMap[T, U any](f *Future[T], fn func(T) *Future[U]) *Future[U] {
+	return StartFuture(context.Background(), func(ctx context.Context) (U, error) {
+		v, err := f.Await(ctx)
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return fn(v).Await(ctx)
+	})
+}
+
+// Recover returns a Future that resolves to f's value if it succeeds, or to
+// fn applied to f's error otherwise, letting fn substitute a fallback value
+// or a different error.
+func Recover[T any](f *Future[T], fn func(error) (T, error)) *Future[T] {
+	return StartFuture(context.Background(), func(ctx context.Context) (T, error) {
+		v, err := f.Await(ctx)
+		if err == nil {
+			return v, nil
+		}
+		return fn(err)
+	})
+}
+
+// Zip returns a Future that resolves once both fa and fb succeed, pairing
+// their values. On failure it resolves to fa's error if fa failed, and to
+// fb's error otherwise: fa is awaited to completion before fb is even looked
+// at, so fa's error takes priority over fb's regardless of which one
+// actually failed first in time.
+func Zip[A, B any](fa *Future[A], fb *Future[B]) *Future[struct {
+	A A
+	B B
+}] {
+	return StartFuture(context.Background(), func(ctx context.Context) (struct {
+		A A
+		B B
+	}, error) {
+		var zero struct {
+			A A
+			B B
+		}
+
+		a, err := fa.Await(ctx)
+		if err != nil {
+			return zero, err
+		}
+		b, err := fb.Await(ctx)
+		if err != nil {
+			return zero, err
+		}
+		return struct {
+			A A
+			B B
+		}{A: a, B: b}, nil
+	})
+}
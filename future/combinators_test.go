@@ -0,0 +1,189 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMap_Success(t *testing.T) {
+	ctx := context.Background()
+	f := StartFuture(ctx, func(ctx context.Context) (int, error) { return 2, nil })
+
+	mapped := Map(f, func(v int) (string, error) { return strings.Repeat("x", v), nil })
+
+	v, err := mapped.Await(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != "xx" {
+		t.Fatalf("expected 'xx', got %v", v)
+	}
+}
+
+func TestMap_ShortCircuitsOnUpstreamError(t *testing.T) {
+	ctx := context.Background()
+	expectedErr := errors.New("upstream failed")
+	f := StartFuture(ctx, func(ctx context.Context) (int, error) { return 0, expectedErr })
+
+	called := false
+	mapped := Map(f, func(v int) (string, error) {
+		called = true
+		return "", nil
+	})
+
+	_, err := mapped.Await(ctx)
+	if err != expectedErr {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+	if called {
+		t.Fatal("fn should not be called when upstream fails")
+	}
+}
+
+func TestMap_PropagatesPanic(t *testing.T) {
+	ctx := context.Background()
+	f := StartFuture(ctx, func(ctx context.Context) (int, error) { return 1, nil })
+
+	mapped := Map(f, func(v int) (string, error) {
+		panic("boom")
+	})
+
+	_, err := mapped.Await(ctx)
+	if err == nil || !strings.Contains(err.Error(), "panic: boom") {
+		t.Fatalf("expected panic error, got %v", err)
+	}
+}
+
+func TestFlatMap_Success(t *testing.T) {
+	ctx := context.Background()
+	f := StartFuture(ctx, func(ctx context.Context) (int, error) { return 2, nil })
+
+	chained := FlatMap(f, func(v int) *Future[string] {
+		return StartFuture(context.Background(), func(ctx context.Context) (string, error) {
+			return strings.Repeat("y", v), nil
+		})
+	})
+
+	v, err := chained.Await(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != "yy" {
+		t.Fatalf("expected 'yy', got %v", v)
+	}
+}
+
+func TestFlatMap_ShortCircuitsOnUpstreamError(t *testing.T) {
+	ctx := context.Background()
+	expectedErr := errors.New("upstream failed")
+	f := StartFuture(ctx, func(ctx context.Context) (int, error) { return 0, expectedErr })
+
+	called := false
+	chained := FlatMap(f, func(v int) *Future[string] {
+		called = true
+		return StartFuture(context.Background(), func(ctx context.Context) (string, error) { return "", nil })
+	})
+
+	_, err := chained.Await(ctx)
+	if err != expectedErr {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+	if called {
+		t.Fatal("fn should not be called when upstream fails")
+	}
+}
+
+func TestFlatMap_CancellationMidChain(t *testing.T) {
+	f := StartFuture(context.Background(), func(ctx context.Context) (int, error) {
+		return 1, nil
+	})
+
+	chained := FlatMap(f, func(v int) *Future[string] {
+		return StartFuture(context.Background(), func(ctx context.Context) (string, error) {
+			time.Sleep(100 * time.Millisecond)
+			return "too late", nil
+		})
+	})
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := chained.Await(timeoutCtx)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRecover_RecoversFromError(t *testing.T) {
+	ctx := context.Background()
+	f := StartFuture(ctx, func(ctx context.Context) (string, error) {
+		return "", errors.New("boom")
+	})
+
+	recovered := Recover(f, func(err error) (string, error) {
+		return "fallback", nil
+	})
+
+	v, err := recovered.Await(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != "fallback" {
+		t.Fatalf("expected 'fallback', got %v", v)
+	}
+}
+
+func TestRecover_PassesThroughSuccess(t *testing.T) {
+	ctx := context.Background()
+	f := StartFuture(ctx, func(ctx context.Context) (string, error) { return "ok", nil })
+
+	called := false
+	recovered := Recover(f, func(err error) (string, error) {
+		called = true
+		return "fallback", nil
+	})
+
+	v, err := recovered.Await(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != "ok" {
+		t.Fatalf("expected 'ok', got %v", v)
+	}
+	if called {
+		t.Fatal("fn should not be called when upstream succeeds")
+	}
+}
+
+func TestZip_Success(t *testing.T) {
+	ctx := context.Background()
+	fa := StartFuture(ctx, func(ctx context.Context) (int, error) { return 1, nil })
+	fb := StartFuture(ctx, func(ctx context.Context) (string, error) { return "a", nil })
+
+	zipped := Zip(fa, fb)
+
+	pair, err := zipped.Await(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pair.A != 1 || pair.B != "a" {
+		t.Fatalf("expected {1 a}, got %+v", pair)
+	}
+}
+
+func TestZip_FirstErrorWins(t *testing.T) {
+	ctx := context.Background()
+	expectedErr := errors.New("a failed")
+	fa := StartFuture(ctx, func(ctx context.Context) (int, error) { return 0, expectedErr })
+	fb := StartFuture(ctx, func(ctx context.Context) (string, error) { return "a", nil })
+
+	zipped := Zip(fa, fb)
+
+	_, err := zipped.Await(ctx)
+	if err != expectedErr {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+}
@@ -0,0 +1,118 @@
+package future
+
+import (
+	"context"
+	"sync"
+)
+
+// Group provides singleflight-style deduplication of Futures sharing a key:
+// concurrent Do calls for the same key coalesce into a single in-flight
+// computation, and every caller gets a Future resolving to the same Result.
+type Group[K comparable, T any] struct {
+	mu       sync.Mutex
+	inflight map[K]*groupEntry[T]
+}
+
+// NewGroup creates an empty Group.
+func NewGroup[K comparable, T any]() *Group[K, T] {
+	return &Group[K, T]{inflight: make(map[K]*groupEntry[T])}
+}
+
+// groupEntry tracks the single in-flight computation for a key: the
+// reference-counted cancellation of the underlying work, and the Result
+// once it becomes available.
+type groupEntry[T any] struct {
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	waiters int
+
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// Do starts fn for key if no computation for key is currently in flight, or
+// joins the existing one otherwise. The returned Future resolves to the
+// shared Result; Future.Shared reports true for every caller except the one
+// that actually started fn.
+//
+// Canceling ctx only affects this caller: the underlying computation's
+// context is canceled once every waiter's ctx is done, and not before.
+func (g *Group[K, T]) Do(ctx context.Context, key K, fn func(context.Context) (T, error)) *Future[T] {
+	g.mu.Lock()
+	e, ok := g.inflight[key]
+	if !ok {
+		groupCtx, cancel := context.WithCancel(context.Background())
+		e = &groupEntry[T]{cancel: cancel, waiters: 1, done: make(chan struct{})}
+		g.inflight[key] = e
+		g.mu.Unlock()
+
+		go g.run(key, e, groupCtx, fn)
+	} else {
+		e.mu.Lock()
+		e.waiters++
+		e.mu.Unlock()
+		g.mu.Unlock()
+	}
+
+	g.trackWaiter(ctx, e)
+
+	return newSharedFuture(ctx, e, ok)
+}
+
+// run executes fn once on behalf of every waiter sharing e's key, records
+// the Result, and evicts the entry so later Do calls for key start fresh.
+func (g *Group[K, T]) run(key K, e *groupEntry[T], groupCtx context.Context, fn func(context.Context) (T, error)) {
+	v, err := StartFuture(groupCtx, fn).Await(context.Background())
+
+	e.mu.Lock()
+	e.value, e.err = v, err
+	e.mu.Unlock()
+
+	g.mu.Lock()
+	if g.inflight[key] == e {
+		delete(g.inflight, key)
+	}
+	g.mu.Unlock()
+	close(e.done)
+}
+
+// trackWaiter decrements e's waiter count once ctx is done, canceling the
+// shared computation only when no waiter remains interested in it.
+func (g *Group[K, T]) trackWaiter(ctx context.Context, e *groupEntry[T]) {
+	go func() {
+		select {
+		case <-e.done:
+			return
+		case <-ctx.Done():
+		}
+
+		e.mu.Lock()
+		e.waiters--
+		remaining := e.waiters
+		e.mu.Unlock()
+
+		if remaining <= 0 {
+			e.cancel()
+		}
+	}()
+}
+
+// newSharedFuture returns a per-caller Future that resolves once e's
+// computation completes or ctx is done, whichever happens first.
+func newSharedFuture[T any](ctx context.Context, e *groupEntry[T], shared bool) *Future[T] {
+	fut := StartFuture(ctx, func(ctx context.Context) (T, error) {
+		select {
+		case <-e.done:
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			return e.value, e.err
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	})
+	fut.shared = shared
+	return fut
+}
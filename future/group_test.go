@@ -0,0 +1,167 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroup_DedupConcurrentCallers(t *testing.T) {
+	ctx := context.Background()
+	g := NewGroup[string, int]()
+
+	var calls int32
+	start := make(chan struct{})
+	fn := func(ctx context.Context) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		<-start
+		return 42, nil
+	}
+
+	const n = 5
+	futs := make([]*Future[int], n)
+	for i := 0; i < n; i++ {
+		futs[i] = g.Do(ctx, "k", fn)
+	}
+	close(start)
+
+	for i, f := range futs {
+		v, err := f.Await(ctx)
+		if err != nil {
+			t.Fatalf("caller %d: unexpected error %v", i, err)
+		}
+		if v != 42 {
+			t.Fatalf("caller %d: expected 42, got %d", i, v)
+		}
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected fn to run once, ran %d times", got)
+	}
+}
+
+func TestGroup_SharedFlag(t *testing.T) {
+	ctx := context.Background()
+	g := NewGroup[string, int]()
+
+	start := make(chan struct{})
+	fn := func(ctx context.Context) (int, error) {
+		<-start
+		return 1, nil
+	}
+
+	first := g.Do(ctx, "k", fn)
+	second := g.Do(ctx, "k", fn)
+	close(start)
+
+	if first.Shared() {
+		t.Fatal("expected first caller's Future to be unshared")
+	}
+	if !second.Shared() {
+		t.Fatal("expected second caller's Future to be shared")
+	}
+
+	if _, err := first.Await(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := second.Await(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestGroup_OneCallerCancelingDoesNotCancelOthers(t *testing.T) {
+	g := NewGroup[string, int]()
+
+	started := make(chan struct{})
+	fn := func(ctx context.Context) (int, error) {
+		close(started)
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	waiterCtx := context.Background()
+
+	f1 := g.Do(cancelCtx, "k", fn)
+	f2 := g.Do(waiterCtx, "k", fn)
+
+	<-started
+	cancel()
+
+	if _, err := f1.Await(context.Background()); err != context.Canceled {
+		t.Fatalf("expected context.Canceled for the canceling caller, got %v", err)
+	}
+
+	timeoutCtx, stop := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer stop()
+	if _, err := f2.Await(timeoutCtx); err != context.DeadlineExceeded {
+		t.Fatalf("expected the other caller's work to still be running, got %v", err)
+	}
+}
+
+func TestGroup_AllCallersCancelingCancelsUnderlyingWork(t *testing.T) {
+	g := NewGroup[string, int]()
+
+	started := make(chan struct{})
+	canceled := make(chan struct{})
+	fn := func(ctx context.Context) (int, error) {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+		return 0, ctx.Err()
+	}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+
+	g.Do(ctx1, "k", fn)
+	g.Do(ctx2, "k", fn)
+
+	<-started
+	cancel1()
+	cancel2()
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("expected underlying work to be canceled once all waiters are gone")
+	}
+}
+
+func TestGroup_EvictedAfterCompletion(t *testing.T) {
+	ctx := context.Background()
+	g := NewGroup[string, int]()
+
+	var calls int32
+	fn := func(ctx context.Context) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		return int(atomic.LoadInt32(&calls)), nil
+	}
+
+	v1, _ := g.Do(ctx, "k", fn).Await(ctx)
+	if v1 != 1 {
+		t.Fatalf("expected 1, got %d", v1)
+	}
+
+	v2, _ := g.Do(ctx, "k", fn).Await(ctx)
+	if v2 != 2 {
+		t.Fatalf("expected entry to be evicted and fn re-run, got %d", v2)
+	}
+}
+
+func TestGroup_PropagatesError(t *testing.T) {
+	ctx := context.Background()
+	g := NewGroup[string, int]()
+	expectedErr := errors.New("boom")
+
+	fut := g.Do(ctx, "k", func(ctx context.Context) (int, error) {
+		return 0, expectedErr
+	})
+
+	_, err := fut.Await(ctx)
+	if err != expectedErr {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+}
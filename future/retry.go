@@ -0,0 +1,136 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy configures the exponential backoff used by Retry. The interval
+// before attempt n (n >= 2) is InitialInterval * Multiplier^(n-2), capped at
+// MaxInterval. MaxAttempts and MaxElapsedTime are both optional (<= 0 means
+// unlimited) stop conditions evaluated after a retryable failure.
+type RetryPolicy struct {
+	InitialInterval time.Duration
+	Multiplier      float64
+	MaxInterval     time.Duration
+	MaxElapsedTime  time.Duration
+	MaxAttempts     int
+
+	// JitterFraction controls how much of the computed interval is
+	// randomized, in [0, 1]. 0 sleeps for exactly the computed interval;
+	// 1 is full jitter (sleep = rand[0, computed_interval]).
+	JitterFraction float64
+}
+
+// backoff returns how long to sleep after the given (1-indexed) attempt
+// failed, before making the next one.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	interval := float64(p.InitialInterval) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxInterval > 0 && interval > float64(p.MaxInterval) {
+		interval = float64(p.MaxInterval)
+	}
+	if interval < 0 {
+		interval = 0
+	}
+
+	jitter := p.JitterFraction
+	switch {
+	case jitter <= 0:
+		return time.Duration(interval)
+	case jitter > 1:
+		jitter = 1
+	}
+
+	base := interval * (1 - jitter)
+	return time.Duration(base + rand.Float64()*interval*jitter)
+}
+
+// ErrRetry is the sentinel recognized by errors.Is to mark an error as
+// retryable. Wrap an error that should trigger another attempt with
+// Retryable(err).
+var ErrRetry = errors.New("future: retryable error")
+
+// retryableError adapts an arbitrary error to errors.Is(err, ErrRetry).
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string        { return e.err.Error() }
+func (e *retryableError) Unwrap() error        { return e.err }
+func (e *retryableError) Is(target error) bool { return target == ErrRetry }
+
+// Retryable wraps err so errors.Is(err, ErrRetry) reports true, signalling to
+// Retry that the attempt producing it should be retried rather than treated
+// as final.
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err}
+}
+
+// isRetryable reports whether err should trigger another attempt: either it
+// (or something it wraps) satisfies ErrRetry, or it implements the
+// conventional `Temporary() bool` interface and reports true.
+func isRetryable(err error) bool {
+	if errors.Is(err, ErrRetry) {
+		return true
+	}
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) {
+		return temporary.Temporary()
+	}
+	return false
+}
+
+// Retry runs fn like StartFuture, but re-invokes it according to policy
+// while its error is retryable (see Retryable and isRetryable), stopping at
+// the first success, the first non-retryable error, MaxAttempts,
+// MaxElapsedTime, or ctx cancellation — whichever comes first. Sleeps
+// between attempts are interruptible by ctx. On failure, the Result's error
+// wraps the last attempt's error together with the number of attempts made;
+// if ctx is done during a sleep between attempts, it wraps ctx.Err() instead.
+func Retry[T any](ctx context.Context, policy RetryPolicy, fn func(context.Context) (T, error)) *Future[T] {
+	return StartFuture(ctx, func(ctx context.Context) (T, error) {
+		start := time.Now()
+		var lastErr error
+		attempt := 0
+
+	attempts:
+		for {
+			attempt++
+
+			v, err := fn(ctx)
+			if err == nil {
+				return v, nil
+			}
+			lastErr = err
+
+			stop := !isRetryable(err) ||
+				(policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts) ||
+				(policy.MaxElapsedTime > 0 && time.Since(start) >= policy.MaxElapsedTime)
+			if stop {
+				break attempts
+			}
+
+			select {
+			case <-time.After(policy.backoff(attempt)):
+			case <-ctx.Done():
+				lastErr = ctx.Err()
+				break attempts
+			}
+		}
+
+		var zero T
+		return zero, fmt.Errorf("after %d attempt(s): %w", attempt, lastErr)
+	})
+}
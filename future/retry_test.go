@@ -0,0 +1,151 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetry_SucceedsAfterRetries(t *testing.T) {
+	ctx := context.Background()
+	policy := RetryPolicy{InitialInterval: time.Millisecond, Multiplier: 2, JitterFraction: 1}
+
+	var attempts int
+	fut := Retry(ctx, policy, func(ctx context.Context) (string, error) {
+		attempts++
+		if attempts < 3 {
+			return "", Retryable(errors.New("not yet"))
+		}
+		return "ok", nil
+	})
+
+	v, err := fut.Await(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if v != "ok" {
+		t.Fatalf("expected 'ok', got %v", v)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetry_StopsOnNonRetryableError(t *testing.T) {
+	ctx := context.Background()
+	policy := RetryPolicy{InitialInterval: time.Millisecond}
+	plainErr := errors.New("permanent")
+
+	var attempts int
+	fut := Retry(ctx, policy, func(ctx context.Context) (string, error) {
+		attempts++
+		return "", plainErr
+	})
+
+	_, err := fut.Await(ctx)
+	if !errors.Is(err, plainErr) {
+		t.Fatalf("expected wrapped plainErr, got %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected exactly 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRetry_StopsAtMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	policy := RetryPolicy{InitialInterval: time.Millisecond, MaxAttempts: 3}
+
+	var attempts int
+	fut := Retry(ctx, policy, func(ctx context.Context) (string, error) {
+		attempts++
+		return "", Retryable(errors.New("always fails"))
+	})
+
+	_, err := fut.Await(ctx)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 3 {
+		t.Fatalf("expected exactly 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetry_StopsAtMaxElapsedTime(t *testing.T) {
+	ctx := context.Background()
+	policy := RetryPolicy{
+		InitialInterval: 20 * time.Millisecond,
+		MaxElapsedTime:  30 * time.Millisecond,
+	}
+
+	var attempts int
+	fut := Retry(ctx, policy, func(ctx context.Context) (string, error) {
+		attempts++
+		return "", Retryable(errors.New("always fails"))
+	})
+
+	start := time.Now()
+	_, err := fut.Await(ctx)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if time.Since(start) > time.Second {
+		t.Fatalf("expected to stop promptly after MaxElapsedTime, took %v", time.Since(start))
+	}
+	if attempts < 1 {
+		t.Fatalf("expected at least 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRetry_InterruptibleByContext(t *testing.T) {
+	policy := RetryPolicy{InitialInterval: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fut := Retry(ctx, policy, func(ctx context.Context) (string, error) {
+		return "", Retryable(errors.New("always fails"))
+	})
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	_, err := fut.Await(context.Background())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected wrapped context.Canceled, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Fatalf("expected sleep to be interrupted promptly, took %v", time.Since(start))
+	}
+}
+
+type temporaryError struct{ temporary bool }
+
+func (e *temporaryError) Error() string   { return "temporary error" }
+func (e *temporaryError) Temporary() bool { return e.temporary }
+
+func TestRetry_RecognizesTemporaryInterface(t *testing.T) {
+	ctx := context.Background()
+	policy := RetryPolicy{InitialInterval: time.Millisecond}
+
+	var attempts int
+	fut := Retry(ctx, policy, func(ctx context.Context) (string, error) {
+		attempts++
+		if attempts < 2 {
+			return "", &temporaryError{temporary: true}
+		}
+		return "ok", nil
+	})
+
+	v, err := fut.Await(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if v != "ok" {
+		t.Fatalf("expected 'ok', got %v", v)
+	}
+}
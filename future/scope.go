@@ -0,0 +1,230 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"sync"
+)
+
+// ScopeOption configures a Scope created by NewScope.
+type ScopeOption func(*scopeConfig)
+
+type scopeConfig struct {
+	maxConcurrency int
+	failFast       bool
+}
+
+// WithMaxConcurrency bounds the number of Scope.Go calls that may be running
+// fn at once; further calls to Go block until a slot frees up. n <= 0 means
+// unbounded, matching the package's default goroutine-per-Future model.
+func WithMaxConcurrency(n int) ScopeOption {
+	return func(c *scopeConfig) {
+		c.maxConcurrency = n
+	}
+}
+
+// WithFailFast cancels the Scope's context as soon as any child Future
+// returns an error, propagating cancellation to every in-flight fn.
+func WithFailFast() ScopeOption {
+	return func(c *scopeConfig) {
+		c.failFast = true
+	}
+}
+
+// Scope is a structured-concurrency owner for a set of same-typed Futures:
+// it bounds their concurrency, optionally fails fast, and lets the caller
+// wait for or select over all of them together. Where StartFuture leaves
+// fan-out and backpressure to the caller, Scope centralizes them.
+type Scope[T any] struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	failFast bool
+	sem      chan struct{}
+
+	mu       sync.Mutex
+	wg       sync.WaitGroup
+	children []*scopeChild[T]
+	errs     []error
+	onErr    sync.Once
+}
+
+// scopeChild holds a Go call's result independently of the *Future[T]
+// returned to the caller, so Wait/All/Any can read it any number of times
+// — including alongside a caller directly Awaiting that Future — without
+// racing to drain Future's single-consumer result channel (see
+// future.go's Await/Try).
+type scopeChild[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// wait blocks until the child's result is ready or ctx is done. Safe to
+// call any number of times: once done is closed, every call returns the
+// same cached result immediately.
+func (c *scopeChild[T]) wait(ctx context.Context) (T, error) {
+	select {
+	case <-c.done:
+		return c.value, c.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// NewScope creates a Scope whose children observe ctx's cancellation, plus
+// whatever cancellation opts add (e.g. WithFailFast).
+func NewScope[T any](ctx context.Context, opts ...ScopeOption) *Scope[T] {
+	cfg := scopeConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	scopeCtx, cancel := context.WithCancel(ctx)
+
+	s := &Scope[T]{
+		ctx:      scopeCtx,
+		cancel:   cancel,
+		failFast: cfg.failFast,
+	}
+	if cfg.maxConcurrency > 0 {
+		s.sem = make(chan struct{}, cfg.maxConcurrency)
+	}
+	return s
+}
+
+// Go starts fn as a child Future, blocking first if the Scope's
+// WithMaxConcurrency limit is reached until a slot is free or the Scope's
+// context is done. The result is also registered with the Scope for Wait,
+// All, and Any, which read their own cached copy of it — so the returned
+// Future can be Awaited directly by the caller any number of times, both
+// before and after Wait/All/Any observe the same result.
+func (s *Scope[T]) Go(fn func(context.Context) (T, error)) *Future[T] {
+	acquired := s.acquire()
+
+	child := &scopeChild[T]{done: make(chan struct{})}
+	s.mu.Lock()
+	s.children = append(s.children, child)
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	fut := StartFuture(context.Background(), func(context.Context) (v T, err error) {
+		defer s.wg.Done()
+		if acquired {
+			defer s.release()
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+			}
+			child.value, child.err = v, err
+			close(child.done)
+			s.recordErr(err)
+		}()
+
+		if err = s.ctx.Err(); err != nil {
+			return v, err
+		}
+		return fn(s.ctx)
+	})
+
+	return fut
+}
+
+// Wait blocks until every Future started via Go has completed, then returns
+// the aggregated errors (via errors.Join), or nil if all succeeded. Wait
+// always releases the Scope's derived context (cancel is idempotent), so a
+// Scope that finishes successfully doesn't linger as a live child of ctx.
+func (s *Scope[T]) Wait() error {
+	defer s.cancel()
+
+	s.wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return errors.Join(s.errs...)
+}
+
+// All waits for every child registered with the Scope so far, returning
+// their values in start order or the first error.
+func (s *Scope[T]) All(ctx context.Context) ([]T, error) {
+	children := s.snapshot()
+
+	out := make([]T, len(children))
+	for i, c := range children {
+		v, err := c.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+// Any returns the first of the Scope's registered children to complete
+// (its value, error, and index), using reflect.Select like the
+// package-level Any.
+func (s *Scope[T]) Any(ctx context.Context) (T, error, int) {
+	var zero T
+
+	children := s.snapshot()
+	if len(children) == 0 {
+		return zero, nil, -1
+	}
+
+	cases := make([]reflect.SelectCase, len(children)+1)
+	cases[0] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+	for i, c := range children {
+		cases[i+1] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(c.done)}
+	}
+
+	chosen, _, _ := reflect.Select(cases)
+	if chosen == 0 {
+		return zero, ctx.Err(), -1
+	}
+
+	idx := chosen - 1
+	c := children[idx]
+	return c.value, c.err, idx
+}
+
+func (s *Scope[T]) snapshot() []*scopeChild[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*scopeChild[T](nil), s.children...)
+}
+
+// acquire blocks until a concurrency slot is available, returning false
+// (without having acquired one) if the Scope's context is done first.
+func (s *Scope[T]) acquire() bool {
+	if s.sem == nil {
+		return false
+	}
+	select {
+	case s.sem <- struct{}{}:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+func (s *Scope[T]) release() {
+	<-s.sem
+}
+
+func (s *Scope[T]) recordErr(err error) {
+	if err == nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.errs = append(s.errs, err)
+	s.mu.Unlock()
+
+	if s.failFast {
+		s.onErr.Do(s.cancel)
+	}
+}
@@ -0,0 +1,185 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScope_WaitAggregatesErrors(t *testing.T) {
+	ctx := context.Background()
+	s := NewScope[int](ctx)
+
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+
+	s.Go(func(ctx context.Context) (int, error) { return 1, nil })
+	s.Go(func(ctx context.Context) (int, error) { return 0, err1 })
+	s.Go(func(ctx context.Context) (int, error) { return 0, err2 })
+
+	err := s.Wait()
+	if !errors.Is(err, err1) || !errors.Is(err, err2) {
+		t.Fatalf("expected joined error containing both errs, got %v", err)
+	}
+}
+
+func TestScope_MaxConcurrencyBounded(t *testing.T) {
+	ctx := context.Background()
+	s := NewScope[int](ctx, WithMaxConcurrency(2))
+
+	var running int32
+	var maxSeen int32
+	release := make(chan struct{})
+
+	// Go blocks once the concurrency limit is reached, so fan out the calls
+	// from their own goroutines, as a real caller doing bounded fan-out would.
+	var launched sync.WaitGroup
+	launched.Add(5)
+	for i := 0; i < 5; i++ {
+		go func() {
+			defer launched.Done()
+			s.Go(func(ctx context.Context) (int, error) {
+				n := atomic.AddInt32(&running, 1)
+				for {
+					old := atomic.LoadInt32(&maxSeen)
+					if n <= old || atomic.CompareAndSwapInt32(&maxSeen, old, n) {
+						break
+					}
+				}
+				<-release
+				atomic.AddInt32(&running, -1)
+				return 0, nil
+			})
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	launched.Wait()
+
+	if err := s.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if atomic.LoadInt32(&maxSeen) > 2 {
+		t.Fatalf("expected at most 2 concurrent, saw %d", maxSeen)
+	}
+}
+
+func TestScope_FailFastCancelsSiblings(t *testing.T) {
+	ctx := context.Background()
+	s := NewScope[int](ctx, WithFailFast())
+
+	boom := errors.New("boom")
+	started := make(chan struct{})
+	canceled := make(chan struct{})
+
+	s.Go(func(ctx context.Context) (int, error) {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+		return 0, ctx.Err()
+	})
+	<-started // ensure the sibling is already running before triggering the failure
+
+	s.Go(func(ctx context.Context) (int, error) {
+		return 0, boom
+	})
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("expected sibling to be canceled after fail-fast error")
+	}
+
+	if err := s.Wait(); !errors.Is(err, boom) {
+		t.Fatalf("expected joined error to contain boom, got %v", err)
+	}
+}
+
+func TestScope_All(t *testing.T) {
+	ctx := context.Background()
+	s := NewScope[int](ctx)
+
+	s.Go(func(ctx context.Context) (int, error) { return 1, nil })
+	s.Go(func(ctx context.Context) (int, error) { return 2, nil })
+
+	vals, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(vals) != 2 {
+		t.Fatalf("expected 2 values, got %v", vals)
+	}
+}
+
+func TestScope_Any(t *testing.T) {
+	ctx := context.Background()
+	s := NewScope[int](ctx)
+
+	s.Go(func(ctx context.Context) (int, error) {
+		time.Sleep(50 * time.Millisecond)
+		return 1, nil
+	})
+	s.Go(func(ctx context.Context) (int, error) {
+		return 2, nil
+	})
+
+	v, err, idx := s.Any(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != 2 || idx != 1 {
+		t.Fatalf("expected fast future (2, idx 1), got (%d, idx %d)", v, idx)
+	}
+}
+
+func TestScope_AllCanBeCalledMoreThanOnce(t *testing.T) {
+	ctx := context.Background()
+	s := NewScope[int](ctx)
+
+	s.Go(func(ctx context.Context) (int, error) { return 1, nil })
+	s.Go(func(ctx context.Context) (int, error) { return 2, nil })
+
+	first, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(second) != 2 || second[0] != 1 || second[1] != 2 {
+		t.Fatalf("expected second All() to see the same values as the first %v, got %v", first, second)
+	}
+}
+
+func TestScope_AllCoexistsWithDirectAwait(t *testing.T) {
+	ctx := context.Background()
+	s := NewScope[int](ctx)
+
+	fut := s.Go(func(ctx context.Context) (int, error) { return 42, nil })
+
+	// The caller awaits the Future returned by Go directly...
+	v, err := fut.Await(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != 42 {
+		t.Fatalf("expected 42, got %d", v)
+	}
+
+	// ...and All must still see the real result, not a zero value left behind
+	// by the direct Await draining Future's single-consumer channel.
+	vals, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(vals) != 1 || vals[0] != 42 {
+		t.Fatalf("expected All() to still report [42], got %v", vals)
+	}
+}
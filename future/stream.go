@@ -0,0 +1,132 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+)
+
+// streamBufferSize is the capacity of a Stream's value channel, letting a
+// producer run a little ahead of a slower consumer without blocking on emit.
+const streamBufferSize = 16
+
+// Stream represents a computation that produces a sequence of values rather
+// than Future's single Result, terminating with a final error (or nil).
+type Stream[T any] struct {
+	ch  chan T
+	err error
+}
+
+// StartStream launches fn in a goroutine and returns a Stream. fn pushes
+// values via emit, which blocks while the Stream's buffer is full, until
+// there is room or ctx is done. fn's return value becomes the Stream's terminal error. Behavior
+// mirrors StartFuture: if ctx is already canceled, fn is not called; a
+// panic in fn is converted to an error instead of crashing the goroutine;
+// and the channel is always closed exactly once, so a consumer ranging over
+// Recv never blocks forever.
+func StartStream[T any](ctx context.Context, fn func(ctx context.Context, emit func(T) error) error) *Stream[T] {
+	s := &Stream[T]{ch: make(chan T, streamBufferSize)}
+
+	go func() {
+		var err error
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+			}
+			s.err = err
+			close(s.ch)
+		}()
+
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		default:
+		}
+
+		emit := func(v T) error {
+			select {
+			case s.ch <- v:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		err = fn(ctx, emit)
+	}()
+
+	return s
+}
+
+// Recv returns the next value from the stream. ok is false once the stream
+// has closed, at which point err holds the Stream's terminal error (nil on
+// a clean finish). Recv is safe to keep calling after the stream closes; it
+// keeps returning the same terminal error.
+func (s *Stream[T]) Recv() (value T, err error, ok bool) {
+	v, ok := <-s.ch
+	if ok {
+		return v, nil, true
+	}
+	return v, s.err, false
+}
+
+// Range calls fn with each value in turn until the stream closes or fn
+// returns false. It returns the stream's terminal error if the stream was
+// drained, or nil if fn stopped the iteration early. Stopping early leaves
+// the producer running (as with StartFuture, cancellation is cooperative):
+// cancel the ctx passed to StartStream if the producer must stop too.
+func (s *Stream[T]) Range(fn func(T) bool) error {
+	for {
+		v, err, ok := s.Recv()
+		if !ok {
+			return err
+		}
+		if !fn(v) {
+			return nil
+		}
+	}
+}
+
+// Merge fans multiple Streams into one, using reflect.Select (mirroring
+// Any) to forward whichever upstream has a value ready without spawning a
+// goroutine per stream. The merged Stream stays open until every upstream
+// has closed, and its terminal error joins (via errors.Join) every
+// non-nil upstream terminal error. As with Range, the forwarding goroutine
+// is only stopped by draining the merged Stream or by every upstream's own
+// ctx being canceled; Merge itself takes no ctx to stop early.
+func Merge[T any](streams ...*Stream[T]) *Stream[T] {
+	out := &Stream[T]{ch: make(chan T)}
+
+	go func() {
+		active := append([]*Stream[T](nil), streams...)
+		var errs []error
+		var cases []reflect.SelectCase
+
+		for len(active) > 0 {
+			if cases == nil {
+				cases = make([]reflect.SelectCase, len(active))
+				for i, s := range active {
+					cases[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(s.ch)}
+				}
+			}
+
+			chosen, value, ok := reflect.Select(cases)
+			if !ok {
+				errs = append(errs, active[chosen].err)
+				active = append(active[:chosen], active[chosen+1:]...)
+				cases = append(cases[:chosen], cases[chosen+1:]...)
+				continue
+			}
+
+			out.ch <- value.Interface().(T)
+		}
+
+		out.err = errors.Join(errs...)
+		close(out.ch)
+	}()
+
+	return out
+}
@@ -0,0 +1,192 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStream_EmitsValuesThenCloses(t *testing.T) {
+	ctx := context.Background()
+	s := StartStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		for i := 1; i <= 3; i++ {
+			if err := emit(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	var got []int
+	for {
+		v, err, ok := s.Recv()
+		if !ok {
+			if err != nil {
+				t.Fatalf("unexpected terminal error: %v", err)
+			}
+			break
+		}
+		got = append(got, v)
+	}
+
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+}
+
+func TestStream_TerminalErrorIsStable(t *testing.T) {
+	ctx := context.Background()
+	expectedErr := errors.New("boom")
+	s := StartStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		_ = emit(1)
+		return expectedErr
+	})
+
+	_, _, _ = s.Recv() // drain the one value
+
+	for i := 0; i < 3; i++ {
+		_, err, ok := s.Recv()
+		if ok {
+			t.Fatal("expected stream to be closed")
+		}
+		if err != expectedErr {
+			t.Fatalf("call %d: expected %v, got %v", i, expectedErr, err)
+		}
+	}
+}
+
+func TestStream_PanicBecomesTerminalError(t *testing.T) {
+	ctx := context.Background()
+	s := StartStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		panic("stream boom")
+	})
+
+	_, err, ok := s.Recv()
+	if ok {
+		t.Fatal("expected stream to be closed immediately")
+	}
+	if err == nil || !strings.Contains(err.Error(), "panic: stream boom") {
+		t.Fatalf("expected panic error, got %v", err)
+	}
+}
+
+func TestStream_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s := StartStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		t.Fatal("fn should not be called")
+		return nil
+	})
+
+	_, err, ok := s.Recv()
+	if ok {
+		t.Fatal("expected stream to be closed immediately")
+	}
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestStream_Range(t *testing.T) {
+	ctx := context.Background()
+	s := StartStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		for i := 1; i <= 5; i++ {
+			if err := emit(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	var got []int
+	err := s.Range(func(v int) bool {
+		got = append(got, v)
+		return v < 3
+	})
+	if err != nil {
+		t.Fatalf("expected nil error on early stop, got %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected to stop after 3 values, got %v", got)
+	}
+}
+
+func TestStream_RangeDrainsToTerminalError(t *testing.T) {
+	ctx := context.Background()
+	expectedErr := errors.New("boom")
+	s := StartStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		_ = emit(1)
+		return expectedErr
+	})
+
+	var got []int
+	err := s.Range(func(v int) bool {
+		got = append(got, v)
+		return true
+	})
+	if err != expectedErr {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("expected 1 value, got %v", got)
+	}
+}
+
+func TestMerge_FansInUntilAllClose(t *testing.T) {
+	ctx := context.Background()
+	a := StartStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		_ = emit(1)
+		_ = emit(2)
+		return nil
+	})
+	b := StartStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		time.Sleep(10 * time.Millisecond)
+		_ = emit(3)
+		return nil
+	})
+
+	merged := Merge(a, b)
+
+	seen := map[int]bool{}
+	err := merged.Range(func(v int) bool {
+		seen[v] = true
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(seen) != 3 || !seen[1] || !seen[2] || !seen[3] {
+		t.Fatalf("expected to see 1, 2 and 3, got %v", seen)
+	}
+}
+
+func TestMerge_JoinsUpstreamErrors(t *testing.T) {
+	ctx := context.Background()
+	err1 := errors.New("a failed")
+	err2 := errors.New("b failed")
+
+	a := StartStream(ctx, func(ctx context.Context, emit func(int) error) error { return err1 })
+	b := StartStream(ctx, func(ctx context.Context, emit func(int) error) error { return err2 })
+
+	merged := Merge(a, b)
+
+	err := merged.Range(func(v int) bool { return true })
+	if !errors.Is(err, err1) || !errors.Is(err, err2) {
+		t.Fatalf("expected joined error containing both, got %v", err)
+	}
+}
+
+func TestMerge_NoStreams(t *testing.T) {
+	merged := Merge[int]()
+
+	_, err, ok := merged.Recv()
+	if ok {
+		t.Fatal("expected empty merge to close immediately")
+	}
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}